@@ -0,0 +1,193 @@
+/* Copyright (c) 2015, Daniel Martí <mvdan@mvdan.cc> */
+/* See LICENSE for licensing information */
+
+package xurls
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/idna"
+	"golang.org/x/net/publicsuffix"
+)
+
+// URLInfo holds structured information about a match returned by a
+// Matcher, as produced by Classify.
+type URLInfo struct {
+	// Match is the original matched text.
+	Match string
+	// Scheme is "http", "https", "mailto", or "" when Match is a bare
+	// host or email address without an explicit scheme.
+	Scheme string
+	// Host is the hostname or IP literal, as written in Match, without
+	// the brackets around an IPv6 literal.
+	Host string
+	// IsIP reports whether Host is an IP literal rather than a domain
+	// name; IsIPv6 further distinguishes the two forms.
+	IsIP, IsIPv6 bool
+	// Private reports whether Host is a loopback, private, or
+	// link-local address. It is only meaningful when IsIP is true.
+	Private bool
+	// ETLDPlusOne is the registrable domain (eTLD+1), e.g.
+	// "example.com". It is empty for IP literals and "localhost".
+	ETLDPlusOne string
+	// IsIDN reports whether Host contains a punycode ("xn--") label.
+	IsIDN bool
+	// Unicode is the Unicode form of Host, decoded from punycode. It
+	// equals Host when IsIDN is false.
+	Unicode string
+}
+
+// Classify parses match, which should be a string previously returned by
+// a Matcher, into structured information about its scheme and host.
+func Classify(match string) (*URLInfo, error) {
+	info := &URLInfo{Match: match}
+
+	switch {
+	case strings.Contains(match, "://"):
+		info.Scheme = strings.ToLower(match[:strings.Index(match, "://")])
+		u, err := url.Parse(match)
+		if err != nil {
+			return nil, fmt.Errorf("xurls: %q: %w", match, err)
+		}
+		info.Host = u.Hostname()
+	case strings.LastIndex(match, "@") >= 0:
+		info.Scheme = "mailto"
+		info.Host = match[strings.LastIndex(match, "@")+1:]
+	default:
+		u, err := url.Parse("//" + match)
+		if err != nil {
+			return nil, fmt.Errorf("xurls: %q: %w", match, err)
+		}
+		info.Host = u.Hostname()
+	}
+	if info.Host == "" {
+		return nil, fmt.Errorf("xurls: %q has no host", match)
+	}
+
+	if ip := net.ParseIP(info.Host); ip != nil {
+		info.IsIP = true
+		info.IsIPv6 = ip.To4() == nil
+		info.Private = ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast()
+		info.Unicode = info.Host
+		return info, nil
+	}
+
+	host := strings.ToLower(info.Host)
+	if etld1, err := publicsuffix.EffectiveTLDPlusOne(host); err == nil {
+		info.ETLDPlusOne = etld1
+	}
+	uni, err := idna.ToUnicode(host)
+	if err != nil {
+		uni = host
+	}
+	info.IsIDN = uni != host
+	if !info.IsIDN {
+		uni = info.Host
+	}
+	info.Unicode = uni
+
+	return info, nil
+}
+
+// FindAllClassified returns the Classify result for each match m finds in
+// s that passes f. f may be nil, in which case no match is filtered out.
+// Matches that fail to classify are omitted.
+func FindAllClassified(m *Matcher, s string, f *Filter) []*URLInfo {
+	var out []*URLInfo
+	for _, match := range m.FindAllString(s, -1) {
+		info, err := Classify(match)
+		if err != nil {
+			continue
+		}
+		if f != nil && !f.Allowed(info.Host) {
+			continue
+		}
+		out = append(out, info)
+	}
+	return out
+}
+
+// FilterMode selects how a Filter treats the hosts it recognises.
+type FilterMode int
+
+const (
+	// Denylist rejects matches whose host, or a parent domain of it, is
+	// in the filter.
+	Denylist FilterMode = iota
+	// Allowlist rejects matches whose host, or a parent domain of it, is
+	// not in the filter.
+	Allowlist
+)
+
+// Filter holds a set of hosts parsed from hosts-file or adblock-style
+// rules, used to post-filter matches by host.
+type Filter struct {
+	mode  FilterMode
+	hosts map[string]struct{}
+}
+
+// NewFilter builds a Filter in the given mode from the lines in r, which
+// may use hosts-file syntax ("0.0.0.0 ads.example.com") or simple
+// adblock-style domain rules ("||ads.example.com^"). Blank lines and
+// lines starting with "#" or "!" are ignored.
+func NewFilter(r io.Reader, mode FilterMode) (*Filter, error) {
+	hosts := make(map[string]struct{})
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "!") {
+			continue
+		}
+		if host := parseFilterLine(line); host != "" {
+			hosts[strings.ToLower(host)] = struct{}{}
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return &Filter{mode: mode, hosts: hosts}, nil
+}
+
+func parseFilterLine(line string) string {
+	if strings.HasPrefix(line, "||") {
+		return strings.TrimSuffix(strings.TrimPrefix(line, "||"), "^")
+	}
+	fields := strings.Fields(line)
+	switch len(fields) {
+	case 1:
+		return fields[0]
+	case 2:
+		if net.ParseIP(fields[0]) != nil {
+			return fields[1]
+		}
+	}
+	return ""
+}
+
+// Allowed reports whether host passes f.
+func (f *Filter) Allowed(host string) bool {
+	matched := f.matches(strings.ToLower(host))
+	if f.mode == Denylist {
+		return !matched
+	}
+	return matched
+}
+
+// matches reports whether host, or one of its parent domains, is in f.
+func (f *Filter) matches(host string) bool {
+	for {
+		if _, ok := f.hosts[host]; ok {
+			return true
+		}
+		i := strings.IndexByte(host, '.')
+		if i < 0 {
+			return false
+		}
+		host = host[i+1:]
+	}
+}
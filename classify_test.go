@@ -0,0 +1,152 @@
+/* Copyright (c) 2015, Daniel Martí <mvdan@mvdan.cc> */
+/* See LICENSE for licensing information */
+
+package xurls
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		match       string
+		scheme      string
+		host        string
+		isIP        bool
+		isIPv6      bool
+		private     bool
+		etldPlusOne string
+		isIDN       bool
+		unicode     string
+	}{
+		{
+			match:       "http://example.com/path",
+			scheme:      "http",
+			host:        "example.com",
+			etldPlusOne: "example.com",
+		},
+		{
+			match:       "https://sub.example.co.uk:8443/x",
+			scheme:      "https",
+			host:        "sub.example.co.uk",
+			etldPlusOne: "example.co.uk",
+		},
+		{
+			match:       "foo@example.com",
+			scheme:      "mailto",
+			host:        "example.com",
+			etldPlusOne: "example.com",
+		},
+		{
+			match:       "example.com",
+			host:        "example.com",
+			etldPlusOne: "example.com",
+		},
+		{
+			match:   "http://192.168.1.1/",
+			scheme:  "http",
+			host:    "192.168.1.1",
+			isIP:    true,
+			private: true,
+		},
+		{
+			match:  "http://[2001:db8::1]/",
+			scheme: "http",
+			host:   "2001:db8::1",
+			isIP:   true,
+			isIPv6: true,
+		},
+		{
+			match:       "http://xn--mller-kva.de/",
+			scheme:      "http",
+			host:        "xn--mller-kva.de",
+			etldPlusOne: "xn--mller-kva.de",
+			isIDN:       true,
+			unicode:     "müller.de",
+		},
+	}
+	for _, tc := range tests {
+		info, err := Classify(tc.match)
+		if err != nil {
+			t.Errorf("Classify(%q) returned error: %v", tc.match, err)
+			continue
+		}
+		if info.Scheme != tc.scheme || info.Host != tc.host || info.IsIP != tc.isIP ||
+			info.IsIPv6 != tc.isIPv6 || info.Private != tc.private ||
+			info.ETLDPlusOne != tc.etldPlusOne || info.IsIDN != tc.isIDN {
+			t.Errorf("Classify(%q) = %+v, want {Scheme:%q Host:%q IsIP:%v IsIPv6:%v Private:%v ETLDPlusOne:%q IsIDN:%v}",
+				tc.match, info, tc.scheme, tc.host, tc.isIP, tc.isIPv6, tc.private, tc.etldPlusOne, tc.isIDN)
+		}
+		if tc.unicode != "" && info.Unicode != tc.unicode {
+			t.Errorf("Classify(%q).Unicode = %q, want %q", tc.match, info.Unicode, tc.unicode)
+		}
+	}
+}
+
+func TestClassifyNoHost(t *testing.T) {
+	if _, err := Classify(""); err == nil {
+		t.Error("Classify(\"\") should have returned an error")
+	}
+}
+
+func TestFilterDenylist(t *testing.T) {
+	f, err := NewFilter(strings.NewReader(`
+# a comment
+0.0.0.0 ads.example.com
+||tracker.example.org^
+plain.example.net
+`), Denylist)
+	if err != nil {
+		t.Fatalf("NewFilter: %v", err)
+	}
+	tests := []struct {
+		host string
+		want bool
+	}{
+		{"ads.example.com", false},
+		{"sub.ads.example.com", false},
+		{"tracker.example.org", false},
+		{"plain.example.net", false},
+		{"example.com", true},
+		{"other.example.org", true},
+	}
+	for _, tc := range tests {
+		if got := f.Allowed(tc.host); got != tc.want {
+			t.Errorf("Denylist.Allowed(%q) = %v, want %v", tc.host, got, tc.want)
+		}
+	}
+}
+
+func TestFilterAllowlist(t *testing.T) {
+	f, err := NewFilter(strings.NewReader("example.com\n"), Allowlist)
+	if err != nil {
+		t.Fatalf("NewFilter: %v", err)
+	}
+	tests := []struct {
+		host string
+		want bool
+	}{
+		{"example.com", true},
+		{"sub.example.com", true},
+		{"other.com", false},
+	}
+	for _, tc := range tests {
+		if got := f.Allowed(tc.host); got != tc.want {
+			t.Errorf("Allowlist.Allowed(%q) = %v, want %v", tc.host, got, tc.want)
+		}
+	}
+}
+
+func TestFindAllClassified(t *testing.T) {
+	f, err := NewFilter(strings.NewReader("blocked.example.com\n"), Denylist)
+	if err != nil {
+		t.Fatalf("NewFilter: %v", err)
+	}
+	m := Relaxed(Options{})
+	s := "visit http://allowed.example.com and http://blocked.example.com too"
+	infos := FindAllClassified(m, s, f)
+	if len(infos) != 1 || infos[0].Host != "allowed.example.com" {
+		t.Errorf("FindAllClassified = %+v, want a single match for allowed.example.com", infos)
+	}
+}
@@ -0,0 +1,5 @@
+/* Copyright (c) 2015, Daniel Martí <mvdan@mvdan.cc> */
+/* See LICENSE for licensing information */
+
+// Package xurls extracts urls from plain text using regular expressions.
+package xurls
@@ -0,0 +1,12 @@
+// Generated by regexgen
+
+package xurls
+
+const (
+	webURL       = `((https?:\/\/((([a-zA-Z0-9\$\-\_\.\+\!\*\'\(\)\,\;\?\&\=]|(\%[a-fA-F0-9]{2})){1,64}(\:([a-zA-Z0-9\$\-\_\.\+\!\*\'\(\)\,\;\?\&\=]|(\%[a-fA-F0-9]{2})){1,25})?\@))?)?((([\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9]([\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9\-]{0,61}[\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9]){0,1}\.)+[\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9]([\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9\-]{0,61}[\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9]){0,1}|(((25[0-5]|2[0-4][0-9]|[0-1][0-9]{2}|[1-9][0-9]|[1-9])\.(25[0-5]|2[0-4][0-9]|[0-1][0-9]{2}|[1-9][0-9]|[1-9]|0)\.(25[0-5]|2[0-4][0-9]|[0-1][0-9]{2}|[1-9][0-9]|[1-9]|0)\.(25[0-5]|2[0-4][0-9]|[0-1][0-9]{2}|[1-9][0-9]|[0-9]))|(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])))|localhost))(\:\d{1,5})?)(\/(([\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9\;\/\?\:\@\&\=\#\~\-\.\+\!\*\'\(\)\,\_])|(\%[a-fA-F0-9]{2}))*)?(\b|$)`
+	strictWebURL = `(https?:\/\/((([a-zA-Z0-9\$\-\_\.\+\!\*\'\(\)\,\;\?\&\=]|(\%[a-fA-F0-9]{2})){1,64}(\:([a-zA-Z0-9\$\-\_\.\+\!\*\'\(\)\,\;\?\&\=]|(\%[a-fA-F0-9]{2})){1,25})?\@))?((([\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9]([\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9\-]{0,61}[\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9]){0,1}\.)+[\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9]([\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9\-]{0,61}[\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9]){0,1}|(((25[0-5]|2[0-4][0-9]|[0-1][0-9]{2}|[1-9][0-9]|[1-9])\.(25[0-5]|2[0-4][0-9]|[0-1][0-9]{2}|[1-9][0-9]|[1-9]|0)\.(25[0-5]|2[0-4][0-9]|[0-1][0-9]{2}|[1-9][0-9]|[1-9]|0)\.(25[0-5]|2[0-4][0-9]|[0-1][0-9]{2}|[1-9][0-9]|[0-9]))|(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])))|localhost))(\:\d{1,5})?)(\/(([\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9\;\/\?\:\@\&\=\#\~\-\.\+\!\*\'\(\)\,\_])|(\%[a-fA-F0-9]{2}))*)?(\b|$)`
+	email        = `[a-zA-Z0-9\.\_\%\-\+]{1,256}\@(([\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9]([\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9\-]{0,61}[\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9]){0,1}\.)+[\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9]([\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9\-]{0,61}[\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9]){0,1}|(((25[0-5]|2[0-4][0-9]|[0-1][0-9]{2}|[1-9][0-9]|[1-9])\.(25[0-5]|2[0-4][0-9]|[0-1][0-9]{2}|[1-9][0-9]|[1-9]|0)\.(25[0-5]|2[0-4][0-9]|[0-1][0-9]{2}|[1-9][0-9]|[1-9]|0)\.(25[0-5]|2[0-4][0-9]|[0-1][0-9]{2}|[1-9][0-9]|[0-9]))|(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])))|localhost)`
+	all          = `(((https?:\/\/((([a-zA-Z0-9\$\-\_\.\+\!\*\'\(\)\,\;\?\&\=]|(\%[a-fA-F0-9]{2})){1,64}(\:([a-zA-Z0-9\$\-\_\.\+\!\*\'\(\)\,\;\?\&\=]|(\%[a-fA-F0-9]{2})){1,25})?\@))?)?((([\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9]([\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9\-]{0,61}[\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9]){0,1}\.)+[\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9]([\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9\-]{0,61}[\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9]){0,1}|(((25[0-5]|2[0-4][0-9]|[0-1][0-9]{2}|[1-9][0-9]|[1-9])\.(25[0-5]|2[0-4][0-9]|[0-1][0-9]{2}|[1-9][0-9]|[1-9]|0)\.(25[0-5]|2[0-4][0-9]|[0-1][0-9]{2}|[1-9][0-9]|[1-9]|0)\.(25[0-5]|2[0-4][0-9]|[0-1][0-9]{2}|[1-9][0-9]|[0-9]))|(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])))|localhost))(\:\d{1,5})?)(\/(([\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9\;\/\?\:\@\&\=\#\~\-\.\+\!\*\'\(\)\,\_])|(\%[a-fA-F0-9]{2}))*)?(\b|$)|[a-zA-Z0-9\.\_\%\-\+]{1,256}\@(([\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9]([\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9\-]{0,61}[\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9]){0,1}\.)+[\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9]([\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9\-]{0,61}[\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9]){0,1}|(((25[0-5]|2[0-4][0-9]|[0-1][0-9]{2}|[1-9][0-9]|[1-9])\.(25[0-5]|2[0-4][0-9]|[0-1][0-9]{2}|[1-9][0-9]|[1-9]|0)\.(25[0-5]|2[0-4][0-9]|[0-1][0-9]{2}|[1-9][0-9]|[1-9]|0)\.(25[0-5]|2[0-4][0-9]|[0-1][0-9]{2}|[1-9][0-9]|[0-9]))|(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])))|localhost))`
+	strict       = `((https?:\/\/((([a-zA-Z0-9\$\-\_\.\+\!\*\'\(\)\,\;\?\&\=]|(\%[a-fA-F0-9]{2})){1,64}(\:([a-zA-Z0-9\$\-\_\.\+\!\*\'\(\)\,\;\?\&\=]|(\%[a-fA-F0-9]{2})){1,25})?\@))?((([\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9]([\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9\-]{0,61}[\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9]){0,1}\.)+[\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9]([\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9\-]{0,61}[\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9]){0,1}|(((25[0-5]|2[0-4][0-9]|[0-1][0-9]{2}|[1-9][0-9]|[1-9])\.(25[0-5]|2[0-4][0-9]|[0-1][0-9]{2}|[1-9][0-9]|[1-9]|0)\.(25[0-5]|2[0-4][0-9]|[0-1][0-9]{2}|[1-9][0-9]|[1-9]|0)\.(25[0-5]|2[0-4][0-9]|[0-1][0-9]{2}|[1-9][0-9]|[0-9]))|(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])))|localhost))(\:\d{1,5})?)(\/(([\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9\;\/\?\:\@\&\=\#\~\-\.\+\!\*\'\(\)\,\_])|(\%[a-fA-F0-9]{2}))*)?(\b|$)|[a-zA-Z0-9\.\_\%\-\+]{1,256}\@(([\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9]([\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9\-]{0,61}[\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9]){0,1}\.)+[\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9]([\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9\-]{0,61}[\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9]){0,1}|(((25[0-5]|2[0-4][0-9]|[0-1][0-9]{2}|[1-9][0-9]|[1-9])\.(25[0-5]|2[0-4][0-9]|[0-1][0-9]{2}|[1-9][0-9]|[1-9]|0)\.(25[0-5]|2[0-4][0-9]|[0-1][0-9]{2}|[1-9][0-9]|[1-9]|0)\.(25[0-5]|2[0-4][0-9]|[0-1][0-9]{2}|[1-9][0-9]|[0-9]))|(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])))|localhost))`
+	domain       = `(([\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9]([\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9\-]{0,61}[\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9]){0,1}\.)+[\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9]([\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9\-]{0,61}[\x{41}-\x{5a}\x{61}-\x{7a}\x{aa}-\x{b5}\x{ba}-\x{c0}\x{c1}-\x{d6}\x{d8}-\x{f6}\x{f8}-\x{2c1}\x{2c6}-\x{2d1}\x{2e0}-\x{2e4}\x{2ec}-\x{2ee}\x{370}-\x{374}\x{376}-\x{377}\x{37a}-\x{37d}\x{37f}-\x{386}\x{388}-\x{38a}\x{38c}-\x{38e}\x{38f}-\x{3a1}\x{3a3}-\x{3f5}\x{3f7}-\x{481}\x{48a}-\x{52f}\x{531}-\x{556}\x{559}-\x{560}\x{561}-\x{588}\x{5d0}-\x{5ea}\x{5ef}-\x{5f2}\x{620}-\x{64a}\x{66e}-\x{66f}\x{671}-\x{6d3}\x{6d5}-\x{6e5}\x{6e6}-\x{6ee}\x{6ef}-\x{6fa}\x{6fb}-\x{6fc}\x{6ff}-\x{710}\x{712}-\x{72f}\x{74d}-\x{7a5}\x{7b1}-\x{7ca}\x{7cb}-\x{7ea}\x{7f4}-\x{7f5}\x{7fa}-\x{800}\x{801}-\x{815}\x{81a}-\x{824}\x{828}-\x{840}\x{841}-\x{858}\x{860}-\x{86a}\x{870}-\x{887}\x{889}-\x{88e}\x{8a0}-\x{8c9}\x{904}-\x{939}\x{93d}-\x{950}\x{958}-\x{961}\x{971}-\x{980}\x{985}-\x{98c}\x{98f}-\x{990}\x{993}-\x{9a8}\x{9aa}-\x{9b0}\x{9b2}-\x{9b6}\x{9b7}-\x{9b9}\x{9bd}-\x{9ce}\x{9dc}-\x{9dd}\x{9df}-\x{9e1}\x{9f0}-\x{9f1}\x{9fc}-\x{a05}\x{a06}-\x{a0a}\x{a0f}-\x{a10}\x{a13}-\x{a28}\x{a2a}-\x{a30}\x{a32}-\x{a33}\x{a35}-\x{a36}\x{a38}-\x{a39}\x{a59}-\x{a5c}\x{a5e}-\x{a72}\x{a73}-\x{a74}\x{a85}-\x{a8d}\x{a8f}-\x{a91}\x{a93}-\x{aa8}\x{aaa}-\x{ab0}\x{ab2}-\x{ab3}\x{ab5}-\x{ab9}\x{abd}-\x{ad0}\x{ae0}-\x{ae1}\x{af9}-\x{b05}\x{b06}-\x{b0c}\x{b0f}-\x{b10}\x{b13}-\x{b28}\x{b2a}-\x{b30}\x{b32}-\x{b33}\x{b35}-\x{b39}\x{b3d}-\x{b5c}\x{b5d}-\x{b5f}\x{b60}-\x{b61}\x{b71}-\x{b83}\x{b85}-\x{b8a}\x{b8e}-\x{b90}\x{b92}-\x{b95}\x{b99}-\x{b9a}\x{b9c}-\x{b9e}\x{b9f}-\x{ba3}\x{ba4}-\x{ba8}\x{ba9}-\x{baa}\x{bae}-\x{bb9}\x{bd0}-\x{c05}\x{c06}-\x{c0c}\x{c0e}-\x{c10}\x{c12}-\x{c28}\x{c2a}-\x{c39}\x{c3d}-\x{c58}\x{c59}-\x{c5a}\x{c5d}-\x{c60}\x{c61}-\x{c80}\x{c85}-\x{c8c}\x{c8e}-\x{c90}\x{c92}-\x{ca8}\x{caa}-\x{cb3}\x{cb5}-\x{cb9}\x{cbd}-\x{cdd}\x{cde}-\x{ce0}\x{ce1}-\x{cf1}\x{cf2}-\x{d04}\x{d05}-\x{d0c}\x{d0e}-\x{d10}\x{d12}-\x{d3a}\x{d3d}-\x{d4e}\x{d54}-\x{d56}\x{d5f}-\x{d61}\x{d7a}-\x{d7f}\x{d85}-\x{d96}\x{d9a}-\x{db1}\x{db3}-\x{dbb}\x{dbd}-\x{dc0}\x{dc1}-\x{dc6}\x{e01}-\x{e30}\x{e32}-\x{e33}\x{e40}-\x{e46}\x{e81}-\x{e82}\x{e84}-\x{e86}\x{e87}-\x{e8a}\x{e8c}-\x{ea3}\x{ea5}-\x{ea7}\x{ea8}-\x{eb0}\x{eb2}-\x{eb3}\x{ebd}-\x{ec0}\x{ec1}-\x{ec4}\x{ec6}-\x{edc}\x{edd}-\x{edf}\x{f00}-\x{f40}\x{f41}-\x{f47}\x{f49}-\x{f6c}\x{f88}-\x{f8c}\x{1000}-\x{102a}\x{103f}-\x{1050}\x{1051}-\x{1055}\x{105a}-\x{105d}\x{1061}-\x{1065}\x{1066}-\x{106e}\x{106f}-\x{1070}\x{1075}-\x{1081}\x{108e}-\x{10a0}\x{10a1}-\x{10c5}\x{10c7}-\x{10cd}\x{10d0}-\x{10fa}\x{10fc}-\x{1248}\x{124a}-\x{124d}\x{1250}-\x{1256}\x{1258}-\x{125a}\x{125b}-\x{125d}\x{1260}-\x{1288}\x{128a}-\x{128d}\x{1290}-\x{12b0}\x{12b2}-\x{12b5}\x{12b8}-\x{12be}\x{12c0}-\x{12c2}\x{12c3}-\x{12c5}\x{12c8}-\x{12d6}\x{12d8}-\x{1310}\x{1312}-\x{1315}\x{1318}-\x{135a}\x{1380}-\x{138f}\x{13a0}-\x{13f5}\x{13f8}-\x{13fd}\x{1401}-\x{166c}\x{166f}-\x{167f}\x{1681}-\x{169a}\x{16a0}-\x{16ea}\x{16f1}-\x{16f8}\x{1700}-\x{1711}\x{171f}-\x{1731}\x{1740}-\x{1751}\x{1760}-\x{176c}\x{176e}-\x{1770}\x{1780}-\x{17b3}\x{17d7}-\x{17dc}\x{1820}-\x{1878}\x{1880}-\x{1884}\x{1887}-\x{18a8}\x{18aa}-\x{18b0}\x{18b1}-\x{18f5}\x{1900}-\x{191e}\x{1950}-\x{196d}\x{1970}-\x{1974}\x{1980}-\x{19ab}\x{19b0}-\x{19c9}\x{1a00}-\x{1a16}\x{1a20}-\x{1a54}\x{1aa7}-\x{1b05}\x{1b06}-\x{1b33}\x{1b45}-\x{1b4c}\x{1b83}-\x{1ba0}\x{1bae}-\x{1baf}\x{1bba}-\x{1be5}\x{1c00}-\x{1c23}\x{1c4d}-\x{1c4f}\x{1c5a}-\x{1c7d}\x{1c80}-\x{1c88}\x{1c90}-\x{1cba}\x{1cbd}-\x{1cbf}\x{1ce9}-\x{1cec}\x{1cee}-\x{1cf3}\x{1cf5}-\x{1cf6}\x{1cfa}-\x{1d00}\x{1d01}-\x{1dbf}\x{1e00}-\x{1f15}\x{1f18}-\x{1f1d}\x{1f20}-\x{1f45}\x{1f48}-\x{1f4d}\x{1f50}-\x{1f57}\x{1f59}-\x{1f5f}\x{1f60}-\x{1f7d}\x{1f80}-\x{1fb4}\x{1fb6}-\x{1fbc}\x{1fbe}-\x{1fc2}\x{1fc3}-\x{1fc4}\x{1fc6}-\x{1fcc}\x{1fd0}-\x{1fd3}\x{1fd6}-\x{1fdb}\x{1fe0}-\x{1fec}\x{1ff2}-\x{1ff4}\x{1ff6}-\x{1ffc}\x{2071}-\x{207f}\x{2090}-\x{209c}\x{2102}-\x{2107}\x{210a}-\x{2113}\x{2115}-\x{2119}\x{211a}-\x{211d}\x{2124}-\x{212a}\x{212b}-\x{212d}\x{212f}-\x{2139}\x{213c}-\x{213f}\x{2145}-\x{2149}\x{214e}-\x{2183}\x{2184}-\x{2c00}\x{2c01}-\x{2ce4}\x{2ceb}-\x{2cee}\x{2cf2}-\x{2cf3}\x{2d00}-\x{2d25}\x{2d27}-\x{2d2d}\x{2d30}-\x{2d67}\x{2d6f}-\x{2d80}\x{2d81}-\x{2d96}\x{2da0}-\x{2da6}\x{2da8}-\x{2dae}\x{2db0}-\x{2db6}\x{2db8}-\x{2dbe}\x{2dc0}-\x{2dc6}\x{2dc8}-\x{2dce}\x{2dd0}-\x{2dd6}\x{2dd8}-\x{2dde}\x{2e2f}-\x{3005}\x{3006}-\x{3031}\x{3032}-\x{3035}\x{303b}-\x{303c}\x{3041}-\x{3096}\x{309d}-\x{309f}\x{30a1}-\x{30fa}\x{30fc}-\x{30ff}\x{3105}-\x{312f}\x{3131}-\x{318e}\x{31a0}-\x{31bf}\x{31f0}-\x{31ff}\x{3400}-\x{4dbf}\x{4e00}-\x{a48c}\x{a4d0}-\x{a4fd}\x{a500}-\x{a60c}\x{a610}-\x{a61f}\x{a62a}-\x{a62b}\x{a640}-\x{a66e}\x{a67f}-\x{a69d}\x{a6a0}-\x{a6e5}\x{a717}-\x{a71f}\x{a722}-\x{a788}\x{a78b}-\x{a7ca}\x{a7d0}-\x{a7d1}\x{a7d3}-\x{a7d5}\x{a7d6}-\x{a7d9}\x{a7f2}-\x{a801}\x{a803}-\x{a805}\x{a807}-\x{a80a}\x{a80c}-\x{a822}\x{a840}-\x{a873}\x{a882}-\x{a8b3}\x{a8f2}-\x{a8f7}\x{a8fb}-\x{a8fd}\x{a8fe}-\x{a90a}\x{a90b}-\x{a925}\x{a930}-\x{a946}\x{a960}-\x{a97c}\x{a984}-\x{a9b2}\x{a9cf}-\x{a9e0}\x{a9e1}-\x{a9e4}\x{a9e6}-\x{a9ef}\x{a9fa}-\x{a9fe}\x{aa00}-\x{aa28}\x{aa40}-\x{aa42}\x{aa44}-\x{aa4b}\x{aa60}-\x{aa76}\x{aa7a}-\x{aa7e}\x{aa7f}-\x{aaaf}\x{aab1}-\x{aab5}\x{aab6}-\x{aab9}\x{aaba}-\x{aabd}\x{aac0}-\x{aac2}\x{aadb}-\x{aadd}\x{aae0}-\x{aaea}\x{aaf2}-\x{aaf4}\x{ab01}-\x{ab06}\x{ab09}-\x{ab0e}\x{ab11}-\x{ab16}\x{ab20}-\x{ab26}\x{ab28}-\x{ab2e}\x{ab30}-\x{ab5a}\x{ab5c}-\x{ab69}\x{ab70}-\x{abe2}\x{ac00}-\x{d7a3}\x{d7b0}-\x{d7c6}\x{d7cb}-\x{d7fb}\x{f900}-\x{fa6d}\x{fa70}-\x{fad9}\x{fb00}-\x{fb06}\x{fb13}-\x{fb17}\x{fb1d}-\x{fb1f}\x{fb20}-\x{fb28}\x{fb2a}-\x{fb36}\x{fb38}-\x{fb3c}\x{fb3e}-\x{fb40}\x{fb41}-\x{fb43}\x{fb44}-\x{fb46}\x{fb47}-\x{fbb1}\x{fbd3}-\x{fd3d}\x{fd50}-\x{fd8f}\x{fd92}-\x{fdc7}\x{fdf0}-\x{fdfb}\x{fe70}-\x{fe74}\x{fe76}-\x{fefc}\x{ff21}-\x{ff3a}\x{ff41}-\x{ff5a}\x{ff66}-\x{ffbe}\x{ffc2}-\x{ffc7}\x{ffca}-\x{ffcf}\x{ffd2}-\x{ffd7}\x{ffda}-\x{ffdc}\x{10000}-\x{1000b}\x{1000d}-\x{10026}\x{10028}-\x{1003a}\x{1003c}-\x{1003d}\x{1003f}-\x{1004d}\x{10050}-\x{1005d}\x{10080}-\x{100fa}\x{10280}-\x{1029c}\x{102a0}-\x{102d0}\x{10300}-\x{1031f}\x{1032d}-\x{10340}\x{10342}-\x{10349}\x{10350}-\x{10375}\x{10380}-\x{1039d}\x{103a0}-\x{103c3}\x{103c8}-\x{103cf}\x{10400}-\x{1049d}\x{104b0}-\x{104d3}\x{104d8}-\x{104fb}\x{10500}-\x{10527}\x{10530}-\x{10563}\x{10570}-\x{1057a}\x{1057c}-\x{1058a}\x{1058c}-\x{10592}\x{10594}-\x{10595}\x{10597}-\x{105a1}\x{105a3}-\x{105b1}\x{105b3}-\x{105b9}\x{105bb}-\x{105bc}\x{10600}-\x{10736}\x{10740}-\x{10755}\x{10760}-\x{10767}\x{10780}-\x{10785}\x{10787}-\x{107b0}\x{107b2}-\x{107ba}\x{10800}-\x{10805}\x{10808}-\x{1080a}\x{1080b}-\x{10835}\x{10837}-\x{10838}\x{1083c}-\x{1083f}\x{10840}-\x{10855}\x{10860}-\x{10876}\x{10880}-\x{1089e}\x{108e0}-\x{108f2}\x{108f4}-\x{108f5}\x{10900}-\x{10915}\x{10920}-\x{10939}\x{10980}-\x{109b7}\x{109be}-\x{109bf}\x{10a00}-\x{10a10}\x{10a11}-\x{10a13}\x{10a15}-\x{10a17}\x{10a19}-\x{10a35}\x{10a60}-\x{10a7c}\x{10a80}-\x{10a9c}\x{10ac0}-\x{10ac7}\x{10ac9}-\x{10ae4}\x{10b00}-\x{10b35}\x{10b40}-\x{10b55}\x{10b60}-\x{10b72}\x{10b80}-\x{10b91}\x{10c00}-\x{10c48}\x{10c80}-\x{10cb2}\x{10cc0}-\x{10cf2}\x{10d00}-\x{10d23}\x{10e80}-\x{10ea9}\x{10eb0}-\x{10eb1}\x{10f00}-\x{10f1c}\x{10f27}-\x{10f30}\x{10f31}-\x{10f45}\x{10f70}-\x{10f81}\x{10fb0}-\x{10fc4}\x{10fe0}-\x{10ff6}\x{11003}-\x{11037}\x{11071}-\x{11072}\x{11075}-\x{11083}\x{11084}-\x{110af}\x{110d0}-\x{110e8}\x{11103}-\x{11126}\x{11144}-\x{11147}\x{11150}-\x{11172}\x{11176}-\x{11183}\x{11184}-\x{111b2}\x{111c1}-\x{111c4}\x{111da}-\x{111dc}\x{11200}-\x{11211}\x{11213}-\x{1122b}\x{1123f}-\x{11240}\x{11280}-\x{11286}\x{11288}-\x{1128a}\x{1128b}-\x{1128d}\x{1128f}-\x{1129d}\x{1129f}-\x{112a8}\x{112b0}-\x{112de}\x{11305}-\x{1130c}\x{1130f}-\x{11310}\x{11313}-\x{11328}\x{1132a}-\x{11330}\x{11332}-\x{11333}\x{11335}-\x{11339}\x{1133d}-\x{11350}\x{1135d}-\x{11361}\x{11400}-\x{11434}\x{11447}-\x{1144a}\x{1145f}-\x{11461}\x{11480}-\x{114af}\x{114c4}-\x{114c5}\x{114c7}-\x{11580}\x{11581}-\x{115ae}\x{115d8}-\x{115db}\x{11600}-\x{1162f}\x{11644}-\x{11680}\x{11681}-\x{116aa}\x{116b8}-\x{11700}\x{11701}-\x{1171a}\x{11740}-\x{11746}\x{11800}-\x{1182b}\x{118a0}-\x{118df}\x{118ff}-\x{11906}\x{11909}-\x{1190c}\x{1190d}-\x{11913}\x{11915}-\x{11916}\x{11918}-\x{1192f}\x{1193f}-\x{11941}\x{119a0}-\x{119a7}\x{119aa}-\x{119d0}\x{119e1}-\x{119e3}\x{11a00}-\x{11a0b}\x{11a0c}-\x{11a32}\x{11a3a}-\x{11a50}\x{11a5c}-\x{11a89}\x{11a9d}-\x{11ab0}\x{11ab1}-\x{11af8}\x{11c00}-\x{11c08}\x{11c0a}-\x{11c2e}\x{11c40}-\x{11c72}\x{11c73}-\x{11c8f}\x{11d00}-\x{11d06}\x{11d08}-\x{11d09}\x{11d0b}-\x{11d30}\x{11d46}-\x{11d60}\x{11d61}-\x{11d65}\x{11d67}-\x{11d68}\x{11d6a}-\x{11d89}\x{11d98}-\x{11ee0}\x{11ee1}-\x{11ef2}\x{11f02}-\x{11f04}\x{11f05}-\x{11f10}\x{11f12}-\x{11f33}\x{11fb0}-\x{12000}\x{12001}-\x{12399}\x{12480}-\x{12543}\x{12f90}-\x{12ff0}\x{13000}-\x{1342f}\x{13441}-\x{13446}\x{14400}-\x{14646}\x{16800}-\x{16a38}\x{16a40}-\x{16a5e}\x{16a70}-\x{16abe}\x{16ad0}-\x{16aed}\x{16b00}-\x{16b2f}\x{16b40}-\x{16b43}\x{16b63}-\x{16b77}\x{16b7d}-\x{16b8f}\x{16e40}-\x{16e7f}\x{16f00}-\x{16f4a}\x{16f50}-\x{16f93}\x{16f94}-\x{16f9f}\x{16fe0}-\x{16fe1}\x{16fe3}-\x{17000}\x{17001}-\x{187f7}\x{18800}-\x{18cd5}\x{18d00}-\x{18d08}\x{1aff0}-\x{1aff3}\x{1aff5}-\x{1affb}\x{1affd}-\x{1affe}\x{1b000}-\x{1b122}\x{1b132}-\x{1b150}\x{1b151}-\x{1b152}\x{1b155}-\x{1b164}\x{1b165}-\x{1b167}\x{1b170}-\x{1b2fb}\x{1bc00}-\x{1bc6a}\x{1bc70}-\x{1bc7c}\x{1bc80}-\x{1bc88}\x{1bc90}-\x{1bc99}\x{1d400}-\x{1d454}\x{1d456}-\x{1d49c}\x{1d49e}-\x{1d49f}\x{1d4a2}-\x{1d4a5}\x{1d4a6}-\x{1d4a9}\x{1d4aa}-\x{1d4ac}\x{1d4ae}-\x{1d4b9}\x{1d4bb}-\x{1d4bd}\x{1d4be}-\x{1d4c3}\x{1d4c5}-\x{1d505}\x{1d507}-\x{1d50a}\x{1d50d}-\x{1d514}\x{1d516}-\x{1d51c}\x{1d51e}-\x{1d539}\x{1d53b}-\x{1d53e}\x{1d540}-\x{1d544}\x{1d546}-\x{1d54a}\x{1d54b}-\x{1d550}\x{1d552}-\x{1d6a5}\x{1d6a8}-\x{1d6c0}\x{1d6c2}-\x{1d6da}\x{1d6dc}-\x{1d6fa}\x{1d6fc}-\x{1d714}\x{1d716}-\x{1d734}\x{1d736}-\x{1d74e}\x{1d750}-\x{1d76e}\x{1d770}-\x{1d788}\x{1d78a}-\x{1d7a8}\x{1d7aa}-\x{1d7c2}\x{1d7c4}-\x{1d7cb}\x{1df00}-\x{1df1e}\x{1df25}-\x{1df2a}\x{1e030}-\x{1e06d}\x{1e100}-\x{1e12c}\x{1e137}-\x{1e13d}\x{1e14e}-\x{1e290}\x{1e291}-\x{1e2ad}\x{1e2c0}-\x{1e2eb}\x{1e4d0}-\x{1e4eb}\x{1e7e0}-\x{1e7e6}\x{1e7e8}-\x{1e7eb}\x{1e7ed}-\x{1e7ee}\x{1e7f0}-\x{1e7fe}\x{1e800}-\x{1e8c4}\x{1e900}-\x{1e943}\x{1e94b}-\x{1ee00}\x{1ee01}-\x{1ee03}\x{1ee05}-\x{1ee1f}\x{1ee21}-\x{1ee22}\x{1ee24}-\x{1ee27}\x{1ee29}-\x{1ee32}\x{1ee34}-\x{1ee37}\x{1ee39}-\x{1ee3b}\x{1ee42}-\x{1ee47}\x{1ee49}-\x{1ee4d}\x{1ee4e}-\x{1ee4f}\x{1ee51}-\x{1ee52}\x{1ee54}-\x{1ee57}\x{1ee59}-\x{1ee61}\x{1ee62}-\x{1ee64}\x{1ee67}-\x{1ee6a}\x{1ee6c}-\x{1ee72}\x{1ee74}-\x{1ee77}\x{1ee79}-\x{1ee7c}\x{1ee7e}-\x{1ee80}\x{1ee81}-\x{1ee89}\x{1ee8b}-\x{1ee9b}\x{1eea1}-\x{1eea3}\x{1eea5}-\x{1eea9}\x{1eeab}-\x{1eebb}\x{20000}-\x{2a6df}\x{2a700}-\x{2b739}\x{2b740}-\x{2b81d}\x{2b820}-\x{2cea1}\x{2ceb0}-\x{2ebe0}\x{2f800}-\x{2fa1d}\x{30000}-\x{3134a}\x{31350}-\x{323af}0-9]){0,1}|(((25[0-5]|2[0-4][0-9]|[0-1][0-9]{2}|[1-9][0-9]|[1-9])\.(25[0-5]|2[0-4][0-9]|[0-1][0-9]{2}|[1-9][0-9]|[1-9]|0)\.(25[0-5]|2[0-4][0-9]|[0-1][0-9]{2}|[1-9][0-9]|[1-9]|0)\.(25[0-5]|2[0-4][0-9]|[0-1][0-9]{2}|[1-9][0-9]|[0-9]))|(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])))|localhost)`
+)
@@ -0,0 +1,378 @@
+/* Copyright (c) 2015, Daniel Martí <mvdan@mvdan.cc> */
+/* See LICENSE for licensing information */
+
+// Package scan finds URLs and email addresses in byte slices with a
+// hand-rolled scanner instead of a compiled regexp. It trades some of the
+// generality of xurls.Matcher for raw throughput: there is no NFA to
+// build or backtrack, and each candidate is matched by walking its bytes
+// directly. A candidate that fails is dropped and the scan resumes at the
+// next rune, so some bytes are read more than once, and every candidate
+// host is copied into a string to be validated, so allocations are not
+// limited to the matches reported.
+package scan
+
+import (
+	"net"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/hpidcock/xurls"
+)
+
+// Match is a URL or email address found by FindAll, together with its
+// byte offsets into the scanned input.
+type Match struct {
+	Text       string
+	Start, End int
+}
+
+// FindAll scans data for URLs and email addresses in a single pass and
+// returns every match in order, validating hosts against the ICANN
+// public suffix list (equivalent to xurls.Relaxed(xurls.Options{})).
+func FindAll(data []byte) []Match {
+	return FindAllOptions(data, xurls.Options{})
+}
+
+// FindAllOptions behaves like FindAll, but validates hosts against opts
+// instead of the ICANN-only default.
+func FindAllOptions(data []byte, opts xurls.Options) []Match {
+	var out []Match
+	for i := 0; i < len(data); {
+		if m, ok := matchAt(data, i, opts); ok {
+			out = append(out, m)
+			i = m.End
+			continue
+		}
+		_, size := utf8.DecodeRune(data[i:])
+		if size == 0 {
+			size = 1
+		}
+		i += size
+	}
+	return out
+}
+
+// matchAt tries to match a URL or email address starting at i, which must
+// be a rune boundary in data.
+func matchAt(data []byte, i int, opts xurls.Options) (Match, bool) {
+	if isHostChar(prevRune(data, i)) {
+		// i is in the middle of a label, e.g. the "a" in "foo.bar.com":
+		// not a valid place to start a match.
+		return Match{}, false
+	}
+	if _, ok := hasScheme(data[i:]); ok {
+		return matchURL(data, i, opts)
+	}
+	if m, ok := matchEmail(data, i, opts); ok {
+		return m, true
+	}
+	return matchURL(data, i, opts)
+}
+
+func hasScheme(b []byte) (scheme string, ok bool) {
+	for _, s := range [...]string{"https://", "http://", "mailto:"} {
+		if len(b) >= len(s) && strings.EqualFold(string(b[:len(s)]), s) {
+			return s, true
+		}
+	}
+	return "", false
+}
+
+// matchURL matches a URL starting at i: an optional scheme, a validated
+// host, an optional port, and an optional path/query/fragment.
+func matchURL(data []byte, i int, opts xurls.Options) (Match, bool) {
+	j := i
+	if scheme, ok := hasScheme(data[j:]); ok {
+		j += len(scheme)
+		// userinfo (e.g. "user:pass@") is only part of the grammar right
+		// after an explicit scheme; a bare "user:pass@host.com" with no
+		// scheme is ambiguous with an email address and is left to
+		// matchEmail instead.
+		j = consumeUserInfo(data, j)
+	}
+
+	hostStart := j
+	hostEnd, ok := consumeHost(data, hostStart)
+	if !ok {
+		return Match{}, false
+	}
+	if !xurls.ValidSuffix(string(data[hostStart:hostEnd]), opts) {
+		return Match{}, false
+	}
+	j = hostEnd
+
+	if j < len(data) && data[j] == ':' {
+		k := j + 1
+		for k < len(data) && k-j-1 < 5 && data[k] >= '0' && data[k] <= '9' {
+			k++
+		}
+		if k > j+1 {
+			j = k
+		}
+	}
+
+	j = consumePath(data, j)
+	end := trimTrailingPunct(data, i, j)
+	if end <= i {
+		return Match{}, false
+	}
+	return Match{Text: string(data[i:end]), Start: i, End: end}, true
+}
+
+// matchEmail matches an email address starting at i: a local part, "@",
+// and a validated host.
+func matchEmail(data []byte, i int, opts xurls.Options) (Match, bool) {
+	j := i
+	for j < len(data) {
+		r, size := utf8.DecodeRune(data[j:])
+		if !isEmailLocalChar(r) {
+			break
+		}
+		j += size
+	}
+	if j == i || j-i > 256 || j >= len(data) || data[j] != '@' {
+		return Match{}, false
+	}
+
+	hostStart := j + 1
+	hostEnd, ok := consumeHost(data, hostStart)
+	if !ok {
+		return Match{}, false
+	}
+	if !xurls.ValidSuffix(string(data[hostStart:hostEnd]), opts) {
+		return Match{}, false
+	}
+
+	end := trimTrailingPunct(data, i, hostEnd)
+	if end <= i {
+		return Match{}, false
+	}
+	return Match{Text: string(data[i:end]), Start: i, End: end}, true
+}
+
+// consumeUserInfo consumes a "user[:password]@" prefix starting at i,
+// mirroring regexgen's userInfo grammar, and returns the offset right
+// after the "@". If data[i:] doesn't hold a valid userinfo, it returns i
+// unchanged: unlike consumeHost, failing to match here isn't an error,
+// since userinfo is optional and matchURL simply treats i as the host
+// start instead.
+func consumeUserInfo(data []byte, i int) int {
+	j := consumeUserInfoPart(data, i, 64)
+	if j == i {
+		return i
+	}
+	if j < len(data) && data[j] == ':' {
+		if k := consumeUserInfoPart(data, j+1, 25); k > j+1 {
+			j = k
+		}
+	}
+	if j >= len(data) || data[j] != '@' {
+		return i
+	}
+	return j + 1
+}
+
+// consumeUserInfoPart consumes up to max userinfo units (an allowed
+// character, or a %XX percent-encoding) starting at i.
+func consumeUserInfoPart(data []byte, i, max int) int {
+	j, n := i, 0
+	for j < len(data) && n < max {
+		adv := userInfoUnitLen(data, j)
+		if adv == 0 {
+			break
+		}
+		j += adv
+		n++
+	}
+	return j
+}
+
+func userInfoUnitLen(data []byte, i int) int {
+	if data[i] == '%' && i+2 < len(data) && isHexDigit(data[i+1]) && isHexDigit(data[i+2]) {
+		return 3
+	}
+	r, size := utf8.DecodeRune(data[i:])
+	if !isUserInfoChar(r) {
+		return 0
+	}
+	return size
+}
+
+func isHexDigit(b byte) bool {
+	return (b >= '0' && b <= '9') || (b >= 'a' && b <= 'f') || (b >= 'A' && b <= 'F')
+}
+
+// consumeHost consumes a localhost literal, an IPv4 literal, or a
+// sequence of two or more dot-separated labels, starting at i. It does
+// not validate the result is a real public suffix; call xurls.ValidSuffix
+// on the result for that.
+func consumeHost(data []byte, i int) (int, bool) {
+	const localhost = "localhost"
+	if len(data) >= i+len(localhost) && string(data[i:i+len(localhost)]) == localhost {
+		end := i + len(localhost)
+		if end == len(data) || !isHostChar(runeAt(data, end)) {
+			return end, true
+		}
+	}
+	if end, ok := consumeIPv4(data, i); ok {
+		return end, true
+	}
+	return consumeHostname(data, i)
+}
+
+func consumeHostname(data []byte, i int) (int, bool) {
+	start, labels := i, 0
+	for {
+		labelStart := i
+		for i < len(data) {
+			r, size := utf8.DecodeRune(data[i:])
+			if !isHostChar(r) && r != '-' {
+				break
+			}
+			i += size
+		}
+		if i == labelStart {
+			break
+		}
+		labels++
+		if i < len(data) && data[i] == '.' {
+			i++
+			continue
+		}
+		break
+	}
+	if labels < 2 {
+		return start, false
+	}
+	if i > start && data[i-1] == '.' {
+		i-- // the scan above consumed a trailing dot with no label after it
+	}
+	return i, true
+}
+
+func consumeIPv4(data []byte, i int) (int, bool) {
+	start := i
+	for octet := 0; octet < 4; octet++ {
+		if octet > 0 {
+			if i >= len(data) || data[i] != '.' {
+				return start, false
+			}
+			i++
+		}
+		digitStart := i
+		for i < len(data) && data[i] >= '0' && data[i] <= '9' {
+			i++
+		}
+		if i == digitStart || i-digitStart > 3 {
+			return start, false
+		}
+	}
+	if net.ParseIP(string(data[start:i])) == nil {
+		return start, false
+	}
+	return i, true
+}
+
+func consumePath(data []byte, i int) int {
+	if i >= len(data) || data[i] != '/' {
+		return i
+	}
+	for i < len(data) {
+		r, size := utf8.DecodeRune(data[i:])
+		if !isPathChar(r) {
+			break
+		}
+		i += size
+	}
+	return i
+}
+
+// trimTrailingPunct removes trailing punctuation that is unlikely to be
+// part of the URL itself, such as the period ending a sentence. A
+// trailing ")" is kept if it balances an unmatched "(" earlier in the
+// match, since that is almost always part of the URL (e.g. a Wikipedia
+// link).
+func trimTrailingPunct(data []byte, start, end int) int {
+	for end > start {
+		r, size := utf8.DecodeLastRune(data[start:end])
+		if r == ')' {
+			match := data[start:end]
+			if strings.Count(string(match), "(") >= strings.Count(string(match), ")") {
+				break
+			}
+			end -= size
+			continue
+		}
+		if !isTrimmablePunct(r) {
+			break
+		}
+		end -= size
+	}
+	return end
+}
+
+func isHostChar(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func isEmailLocalChar(r rune) bool {
+	if isHostChar(r) {
+		return true
+	}
+	switch r {
+	case '.', '_', '%', '-', '+':
+		return true
+	}
+	return false
+}
+
+// isUserInfoChar reports whether r is part of the unencoded userinfo
+// charset (regexgen's userInfo grammar): ASCII letters, digits, and a
+// fixed set of punctuation. Percent-encoded bytes are handled separately
+// by consumeUserInfoPart.
+func isUserInfoChar(r rune) bool {
+	if r < utf8.RuneSelf && (('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || ('0' <= r && r <= '9')) {
+		return true
+	}
+	switch r {
+	case '$', '-', '_', '.', '+', '!', '*', '\'', '(', ')', ',', ';', '?', '&', '=':
+		return true
+	}
+	return false
+}
+
+func isPathChar(r rune) bool {
+	if isHostChar(r) {
+		return true
+	}
+	switch r {
+	case '/', ';', '?', ':', '@', '&', '=', '#', '~', '-', '.', '+', '!', '*', '\'', '(', ')', ',', '_', '%':
+		return true
+	}
+	return false
+}
+
+func isTrimmablePunct(r rune) bool {
+	switch r {
+	case '.', ',', ';', ':', '!', '?', '\'', '"':
+		return true
+	}
+	return false
+}
+
+func prevRune(data []byte, i int) rune {
+	if i <= 0 {
+		return 0
+	}
+	r, _ := utf8.DecodeLastRune(data[:i])
+	return r
+}
+
+func runeAt(data []byte, i int) rune {
+	if i >= len(data) {
+		return 0
+	}
+	r, _ := utf8.DecodeRune(data[i:])
+	return r
+}
@@ -0,0 +1,131 @@
+/* Copyright (c) 2015, Daniel Martí <mvdan@mvdan.cc> */
+/* See LICENSE for licensing information */
+
+package scan
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hpidcock/xurls"
+)
+
+var findAllTests = []struct {
+	in   string
+	want []string
+}{
+	{"", nil},
+	{"see http://example.com/path for details", []string{"http://example.com/path"}},
+	{"email me at foo@example.com today", []string{"foo@example.com"}},
+	{"e.g. this isn't a url", nil},
+	{"bare.domain.com in prose", []string{"bare.domain.com"}},
+	{"http://a.b:c@example.com/path", []string{"http://a.b:c@example.com/path"}},
+	{"http://user@xn--mller-kva.de/p", []string{"http://user@xn--mller-kva.de/p"}},
+	{"trailing dot example.com.", []string{"example.com"}},
+	{"(see http://example.com/wiki_(disambiguation))", []string{"http://example.com/wiki_(disambiguation)"}},
+	{"192.0.2.1:8080/path is an IPv4 URL", []string{"192.0.2.1:8080/path"}},
+	{"not.a.real.tld isn't a host, i.e. is not either", nil},
+	{"mailto:foo@example.com is also a match", []string{"mailto:foo@example.com"}},
+}
+
+func TestFindAll(t *testing.T) {
+	for _, tc := range findAllTests {
+		matches := FindAll([]byte(tc.in))
+		var got []string
+		for _, m := range matches {
+			got = append(got, m.Text)
+		}
+		if !equalStrings(got, tc.want) {
+			t.Errorf("FindAll(%q) = %v, want %v", tc.in, got, tc.want)
+		}
+		for _, m := range matches {
+			if tc.in[m.Start:m.End] != m.Text {
+				t.Errorf("FindAll(%q): Match{Start:%d,End:%d}.Text = %q, want %q", tc.in, m.Start, m.End, m.Text, tc.in[m.Start:m.End])
+			}
+		}
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// parityCorpus holds inputs exercised against both FindAll and
+// xurls.Relaxed, to check the hand-rolled scanner agrees with the regexp
+// matcher it's meant to be a fast-path alternative to.
+var parityCorpus = []string{
+	"",
+	"no urls here, just prose.",
+	"see http://example.com/path for details",
+	"https://example.com:8443/a/b?x=1#frag",
+	"email me at foo@example.com today",
+	"http://a.b:c@example.com/path",
+	"http://user@xn--mller-kva.de/p",
+	"bare.domain.com and other.example.org in one line",
+	"192.0.2.1 as an IPv4 literal",
+	"a sentence ending in e.g. and i.e. and etc.",
+	"repeated.example.com repeated.example.com repeated.example.com",
+}
+
+// The corpus above deliberately excludes two cases where scan.FindAll and
+// xurls.Relaxed are expected to diverge by design, covered separately in
+// TestFindAll instead: a literal "mailto:" prefix (which scan.go treats as
+// a scheme per its originating request, but the regexp grammar doesn't),
+// and unbalanced trailing parentheses (where scan.go's trimTrailingPunct
+// does balanced-paren counting that the regexp has no way to express).
+
+func TestFindAllParityWithRelaxed(t *testing.T) {
+	m := xurls.Relaxed(xurls.Options{})
+	for _, in := range parityCorpus {
+		var got []string
+		for _, match := range FindAll([]byte(in)) {
+			got = append(got, match.Text)
+		}
+		want := m.FindAllString(in, -1)
+		if !equalStrings(got, want) {
+			t.Errorf("FindAll(%q) = %v, want %v (from xurls.Relaxed)", in, got, want)
+		}
+	}
+}
+
+func BenchmarkFindAllScan(b *testing.B) {
+	text := []byte(strings.Repeat(benchmarkText, 50))
+	b.SetBytes(int64(len(text)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		FindAll(text)
+	}
+}
+
+func BenchmarkFindAllRelaxed(b *testing.B) {
+	text := strings.Repeat(benchmarkText, 50)
+	m := xurls.Relaxed(xurls.Options{})
+	b.SetBytes(int64(len(text)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		m.FindAllString(text, -1)
+	}
+}
+
+const benchmarkText = `Check out http://example.com/path?query=1 and also
+visit https://sub.example.org:8443/a/b for more, or email
+someone at foo.bar@example.com if you have questions. Some
+prose mentions e.g. and i.e. and etc. without meaning a host,
+and a bare domain like example.net shows up here too.
+`
+
+func ExampleFindAll() {
+	for _, m := range FindAll([]byte("visit http://example.com today")) {
+		fmt.Println(m.Text)
+	}
+	// Output: http://example.com
+}
@@ -0,0 +1,134 @@
+/* Copyright (c) 2015, Daniel Martí <mvdan@mvdan.cc> */
+/* See LICENSE for licensing information */
+
+// Package stream finds URLs and email addresses in an io.Reader without
+// requiring the whole input to be held in memory, so that large inputs
+// such as chat logs or mbox archives can be scanned a window at a time.
+package stream
+
+import (
+	"bufio"
+	"io"
+
+	"github.com/hpidcock/xurls"
+)
+
+// DefaultWindowSize is the window size used by FindReader and
+// FindReaderFunc. Consecutive windows overlap by a quarter of the window
+// size, and a match that straddles a window boundary is only reported
+// whole if it is no longer than that overlap (1 KiB for this default).
+const DefaultWindowSize = 4 * 1024
+
+// Match is a regexp match found in a stream, along with the byte offset
+// at which it starts.
+type Match struct {
+	Text   string
+	Offset int64
+}
+
+// FindReader scans r for matches of m using DefaultWindowSize, sending
+// each one on the returned channel in order. The channel is closed once r
+// is exhausted or an error is encountered; callers that need to tell the
+// two apart should use FindReaderFunc instead.
+func FindReader(r io.Reader, m *xurls.Matcher) <-chan Match {
+	out := make(chan Match)
+	go func() {
+		defer close(out)
+		FindReaderFunc(r, m, func(match Match) error {
+			out <- match
+			return nil
+		})
+	}()
+	return out
+}
+
+// FindReaderFunc scans r for matches of m using DefaultWindowSize, calling
+// fn for each one in order. Scanning stops at the first error returned by
+// fn or encountered while reading r.
+func FindReaderFunc(r io.Reader, m *xurls.Matcher, fn func(Match) error) error {
+	return findReaderFunc(r, m, fn, DefaultWindowSize)
+}
+
+// FindReaderFuncSize behaves like FindReaderFunc, but uses windows of
+// windowSize bytes instead of DefaultWindowSize. A match that straddles a
+// window boundary is only reported whole if it is no longer than
+// windowSize/4, the overlap between consecutive windows.
+func FindReaderFuncSize(r io.Reader, m *xurls.Matcher, fn func(Match) error, windowSize int) error {
+	return findReaderFunc(r, m, fn, windowSize)
+}
+
+func findReaderFunc(r io.Reader, m *xurls.Matcher, fn func(Match) error, windowSize int) error {
+	overlap := windowSize / 4
+	if overlap < 1 {
+		overlap = 1
+	}
+	ws := &windowSplitter{size: windowSize, overlap: overlap}
+
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, windowSize), windowSize)
+	sc.Split(ws.split)
+
+	var highWater int64
+	for sc.Scan() {
+		window := sc.Text()
+		for _, idx := range m.FindAllStringIndex(window, -1) {
+			start := ws.tokenStart + int64(idx[0])
+			if start < highWater {
+				// already reported from the previous, overlapping window
+				continue
+			}
+			if idx[1] == len(window) && !ws.atEOF {
+				// This match runs right up to the edge of the window, so
+				// it may really continue beyond it and just be getting
+				// cut short here (e.g. "http://example.co" is itself a
+				// valid match, but could be a truncated
+				// "http://example.com/path"). The next window overlaps
+				// this one and has more trailing context, so defer to
+				// it instead of reporting a possibly-truncated match.
+				continue
+			}
+			if err := fn(Match{
+				Text:   window[idx[0]:idx[1]],
+				Offset: start,
+			}); err != nil {
+				return err
+			}
+			highWater = ws.tokenStart + int64(idx[1])
+		}
+	}
+	return sc.Err()
+}
+
+// windowSplitter is a bufio.SplitFunc that yields fixed-size windows of
+// the input, each overlapping the previous one by overlap bytes so that a
+// match straddling a window boundary still appears whole in the next
+// window.
+type windowSplitter struct {
+	size, overlap int
+	next          int64 // offset of the byte after the last one handed out
+	tokenStart    int64 // offset of the start of the most recently returned token
+	atEOF         bool  // whether the most recently returned token reached the end of the input
+}
+
+func (w *windowSplitter) split(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	if atEOF && len(data) == 0 {
+		return 0, nil, nil
+	}
+	if len(data) < w.size && !atEOF {
+		return 0, nil, nil // request a full window before acting
+	}
+
+	size := w.size
+	if len(data) < size {
+		size = len(data)
+	}
+	advance = size
+	if !atEOF && size > w.overlap {
+		advance = size - w.overlap
+	}
+
+	w.atEOF = atEOF && size == len(data)
+	w.tokenStart = w.next
+	w.next += int64(advance)
+	return advance, data[:size], nil
+}
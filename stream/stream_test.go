@@ -0,0 +1,78 @@
+/* Copyright (c) 2015, Daniel Martí <mvdan@mvdan.cc> */
+/* See LICENSE for licensing information */
+
+package stream
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hpidcock/xurls"
+)
+
+func collect(t *testing.T, r string, windowSize int) []Match {
+	t.Helper()
+	m := xurls.Relaxed(xurls.Options{})
+	var got []Match
+	err := FindReaderFuncSize(strings.NewReader(r), m, func(match Match) error {
+		got = append(got, match)
+		return nil
+	}, windowSize)
+	if err != nil {
+		t.Fatalf("FindReaderFuncSize: %v", err)
+	}
+	return got
+}
+
+func TestFindReaderFuncSmallInput(t *testing.T) {
+	got := collect(t, "see http://example.com/path for details", DefaultWindowSize)
+	if len(got) != 1 || got[0].Text != "http://example.com/path" || got[0].Offset != 4 {
+		t.Errorf("got %+v, want a single match for http://example.com/path at offset 4", got)
+	}
+}
+
+// TestFindReaderFuncBoundary builds input long enough to need many windows
+// and sprinkles short matches every few bytes, so that most of them land on
+// or straddle a window boundary somewhere in the sequence. Each one must
+// still be reported exactly once, at its real offset, matching what
+// scanning the whole string in one shot would find.
+func TestFindReaderFuncBoundary(t *testing.T) {
+	const windowSize = 128 // overlap = windowSize/4 = 32, comfortably >= len("http://example.com/p")
+
+	var b strings.Builder
+	for i := 0; i < 60; i++ {
+		b.WriteString(strings.Repeat("filler ", 3))
+		b.WriteString("http://example.com/p")
+		b.WriteString(" ")
+	}
+	text := b.String()
+
+	m := xurls.Relaxed(xurls.Options{})
+	want := m.FindAllStringIndex(text, -1)
+	if len(want) == 0 {
+		t.Fatal("test is broken: xurls.Relaxed found no matches in the fixture")
+	}
+
+	got := collect(t, text, windowSize)
+	if len(got) != len(want) {
+		t.Fatalf("got %d matches, want %d (from scanning the whole string at once)", len(got), len(want))
+	}
+	for i, idx := range want {
+		wantText := text[idx[0]:idx[1]]
+		if got[i].Text != wantText || int(got[i].Offset) != idx[0] {
+			t.Errorf("match %d = {%q, %d}, want {%q, %d}", i, got[i].Text, got[i].Offset, wantText, idx[0])
+		}
+	}
+}
+
+func TestFindReader(t *testing.T) {
+	m := xurls.Relaxed(xurls.Options{})
+	ch := FindReader(strings.NewReader("visit http://example.com today"), m)
+	var got []Match
+	for match := range ch {
+		got = append(got, match)
+	}
+	if len(got) != 1 || got[0].Text != "http://example.com" {
+		t.Errorf("got %+v, want a single match for http://example.com", got)
+	}
+}
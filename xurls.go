@@ -0,0 +1,198 @@
+/* Copyright (c) 2015, Daniel Martí <mvdan@mvdan.cc> */
+/* See LICENSE for licensing information */
+
+package xurls
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/idna"
+	"golang.org/x/net/publicsuffix"
+)
+
+// Options configures which hosts a Matcher accepts as valid. The zero value
+// of Options matches only suffixes assigned by ICANN.
+type Options struct {
+	// Private, when true, also accepts privately registered suffixes such
+	// as "blogspot.com", in addition to those assigned by ICANN.
+	Private bool
+	// ExtraSuffixes are accepted as valid host suffixes even though they
+	// are not part of the public suffix list, e.g. unofficial TLDs like
+	// "onion".
+	ExtraSuffixes []string
+}
+
+// Matcher finds URLs and email addresses in text. Unlike a plain
+// regexp.Regexp, it rejects candidates whose host does not end in a
+// suffix accepted by its Options.
+type Matcher struct {
+	re   *regexp.Regexp
+	opts Options
+}
+
+var domainRe = regexp.MustCompile(domain)
+
+// schemePrefixRe and userInfoPrefixRe strip the parts of a match that come
+// before the host, so that domainRe is applied at the host's actual
+// position instead of searching the whole match for the first dotted
+// label. A userinfo or email local part can itself contain dots (e.g.
+// "a.b" in "http://a.b:c@example.com"), which domainRe would otherwise
+// mistake for the host.
+var (
+	schemePrefixRe   = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9+.-]*://`)
+	userInfoPrefixRe = regexp.MustCompile(`^[^/@]*@`)
+)
+
+// hostIndex returns the boundaries of the host within match, a string
+// previously returned by a Matcher, or (-1, -1) if match has no host.
+func hostIndex(match string) (start, end int) {
+	rest, off := match, 0
+	if loc := schemePrefixRe.FindStringIndex(rest); loc != nil {
+		rest, off = rest[loc[1]:], off+loc[1]
+	}
+	if loc := userInfoPrefixRe.FindStringIndex(rest); loc != nil {
+		rest, off = rest[loc[1]:], off+loc[1]
+	}
+	loc := domainRe.FindStringIndex(rest)
+	if loc == nil {
+		return -1, -1
+	}
+	return off + loc[0], off + loc[1]
+}
+
+// Relaxed returns a Matcher that finds URLs with or without a scheme, as
+// well as email addresses.
+func Relaxed(opts Options) *Matcher {
+	return &Matcher{re: relaxedRe, opts: opts}
+}
+
+// Strict returns a Matcher that only finds URLs containing a scheme, as
+// well as email addresses.
+func Strict(opts Options) *Matcher {
+	return &Matcher{re: strictRe, opts: opts}
+}
+
+var (
+	relaxedRe = regexp.MustCompile(all)
+	strictRe  = regexp.MustCompile(strict)
+)
+
+// FindString returns the first match in s, or "" if there is none.
+func (m *Matcher) FindString(s string) string {
+	idx := m.FindStringIndex(s)
+	if idx == nil {
+		return ""
+	}
+	return s[idx[0]:idx[1]]
+}
+
+// FindStringIndex returns the boundaries of the first match in s, or nil
+// if there is none.
+func (m *Matcher) FindStringIndex(s string) []int {
+	idx := m.FindAllStringIndex(s, 1)
+	if len(idx) == 0 {
+		return nil
+	}
+	return idx[0]
+}
+
+// FindAllString returns all non-overlapping matches in s, or nil if there
+// are none. As with regexp.Regexp.FindAllString, a negative n returns all
+// matches.
+func (m *Matcher) FindAllString(s string, n int) []string {
+	idxs := m.FindAllStringIndex(s, n)
+	if idxs == nil {
+		return nil
+	}
+	out := make([]string, len(idxs))
+	for i, idx := range idxs {
+		out[i] = s[idx[0]:idx[1]]
+	}
+	return out
+}
+
+// FindAllStringIndex returns the boundaries of all non-overlapping matches
+// in s, or nil if there are none. As with regexp.Regexp.FindAllStringIndex,
+// a negative n returns all matches.
+func (m *Matcher) FindAllStringIndex(s string, n int) [][]int {
+	var out [][]int
+	for _, idx := range m.re.FindAllStringIndex(s, -1) {
+		if n >= 0 && len(out) >= n {
+			break
+		}
+		match := s[idx[0]:idx[1]]
+		hs, he := hostIndex(match)
+		if hs < 0 || !ValidSuffix(match[hs:he], m.opts) {
+			continue
+		}
+		out = append(out, idx)
+	}
+	return out
+}
+
+// ValidSuffix reports whether host is acceptable under opts: an IP
+// literal, "localhost", a suffix from opts.ExtraSuffixes, or a suffix
+// known to golang.org/x/net/publicsuffix (ICANN-assigned, or also
+// privately registered when opts.Private is set). Unicode (IDN) hosts are
+// converted to their punycode form before the suffix list lookup, since
+// the list itself is ASCII-only.
+//
+// It is used by Matcher, and is exported so that other matchers, such as
+// the hand-rolled scanner in xurls/scan, can apply the same rules.
+func ValidSuffix(host string, opts Options) bool {
+	if host == "localhost" {
+		return true
+	}
+	if ip := strings.Trim(host, "[]"); net.ParseIP(ip) != nil {
+		return true
+	}
+	host = strings.ToLower(host)
+	for _, suffix := range opts.ExtraSuffixes {
+		suffix = strings.ToLower(suffix)
+		if host == suffix || strings.HasSuffix(host, "."+suffix) {
+			return true
+		}
+	}
+	ascii, err := idna.ToASCII(host)
+	if err != nil {
+		ascii = host
+	}
+	suffix, icann := publicsuffix.PublicSuffix(ascii)
+	if suffix == ascii {
+		// host is itself a public suffix, not a registrable domain.
+		return false
+	}
+	if icann {
+		return true
+	}
+	// publicsuffix reports icann=false both for suffixes explicitly listed
+	// in the PSL's PRIVATE section (e.g. "blogspot.com") and for TLDs it
+	// has never heard of, which fall back to treating the last label as
+	// the suffix. The two can be told apart: a private-section match is
+	// always more than one label, since it's registered under a real
+	// TLD, while the fallback never is. Accept the former under
+	// opts.Private and reject the latter even then, or any dotted token
+	// in prose (e.g. "i.e." or "etc.") would pass as a host.
+	return opts.Private && strings.Contains(suffix, ".")
+}
+
+// Normalize returns the canonical punycode form of match, which should be
+// a string previously returned by a Matcher. Only the host portion of
+// match is converted: it is lower-cased, and any Unicode labels are
+// encoded as punycode. The scheme, userinfo, port, and path are returned
+// unchanged.
+func Normalize(match string) (string, error) {
+	hs, he := hostIndex(match)
+	if hs < 0 {
+		return "", fmt.Errorf("xurls: %q has no host to normalize", match)
+	}
+	host := match[hs:he]
+	ascii, err := idna.ToASCII(strings.ToLower(host))
+	if err != nil {
+		return "", fmt.Errorf("xurls: %q: %w", host, err)
+	}
+	return match[:hs] + ascii + match[he:], nil
+}
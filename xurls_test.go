@@ -0,0 +1,111 @@
+/* Copyright (c) 2015, Daniel Martí <mvdan@mvdan.cc> */
+/* See LICENSE for licensing information */
+
+package xurls
+
+import "testing"
+
+var relaxedMatchTests = []struct {
+	in   string
+	want []string
+}{
+	{"", nil},
+	{"no urls here", nil},
+	{"see http://example.com/path for details", []string{"http://example.com/path"}},
+	{"bare.domain.com in prose", []string{"bare.domain.com"}},
+	{"email me at foo@example.com", []string{"foo@example.com"}},
+	{"a sentence ending in e.g. and i.e. and etc.", nil},
+	{"http://a.b:c@example.com/path", []string{"http://a.b:c@example.com/path"}},
+	{"two urls: http://a.com and http://b.com", []string{"http://a.com", "http://b.com"}},
+}
+
+func TestMatcherRelaxed(t *testing.T) {
+	m := Relaxed(Options{})
+	for _, tc := range relaxedMatchTests {
+		got := m.FindAllString(tc.in, -1)
+		if !equalStringSlices(got, tc.want) {
+			t.Errorf("Relaxed FindAllString(%q) = %v, want %v", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestMatcherStrict(t *testing.T) {
+	m := Strict(Options{})
+	got := m.FindAllString("bare.domain.com has no scheme but http://example.com does", -1)
+	want := []string{"http://example.com"}
+	if !equalStringSlices(got, want) {
+		t.Errorf("Strict FindAllString = %v, want %v", got, want)
+	}
+}
+
+func TestMatcherFindString(t *testing.T) {
+	m := Relaxed(Options{})
+	if got := m.FindString("no urls here"); got != "" {
+		t.Errorf("FindString(no match) = %q, want \"\"", got)
+	}
+	if got := m.FindString("see http://example.com for details"); got != "http://example.com" {
+		t.Errorf("FindString = %q, want %q", got, "http://example.com")
+	}
+}
+
+func equalStringSlices(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestValidSuffix(t *testing.T) {
+	tests := []struct {
+		host string
+		opts Options
+		want bool
+	}{
+		{"example.com", Options{}, true},
+		{"localhost", Options{}, true},
+		{"192.168.1.1", Options{}, true},
+		{"[2001:db8::1]", Options{}, true},
+		{"com", Options{}, false},
+		{"e.g", Options{}, false},
+		{"e.g", Options{Private: true}, false},
+		{"i.e", Options{Private: true}, false},
+		{"foo.blogspot.com", Options{}, false},
+		{"foo.blogspot.com", Options{Private: true}, true},
+		{"example.unofficialtld", Options{}, false},
+		{"example.unofficialtld", Options{ExtraSuffixes: []string{"unofficialtld"}}, true},
+	}
+	for _, tc := range tests {
+		if got := ValidSuffix(tc.host, tc.opts); got != tc.want {
+			t.Errorf("ValidSuffix(%q, %+v) = %v, want %v", tc.host, tc.opts, got, tc.want)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		match   string
+		want    string
+		wantErr bool
+	}{
+		{"http://example.com/path", "http://example.com/path", false},
+		{"http://müller.de/", "http://xn--mller-kva.de/", false},
+		{"http://a.b:c@example.com/path", "http://a.b:c@example.com/path", false},
+		{"user@müller.de", "user@xn--mller-kva.de", false},
+		{"not a url", "", true},
+	}
+	for _, tc := range tests {
+		got, err := Normalize(tc.match)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("Normalize(%q) error = %v, wantErr %v", tc.match, err, tc.wantErr)
+			continue
+		}
+		if err == nil && got != tc.want {
+			t.Errorf("Normalize(%q) = %q, want %q", tc.match, got, tc.want)
+		}
+	}
+}